@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// aclUsers returns the usernames listed in the database's `acl` block, or nil if the
+// block isn't set.
+func aclUsers(d *schema.ResourceData) []string {
+	raw := d.Get("acl").([]interface{})
+	if len(raw) != 1 {
+		return nil
+	}
+	acl := raw[0].(map[string]interface{})
+
+	var list []string
+	for _, u := range acl["users"].(*schema.Set).List() {
+		list = append(list, u.(string))
+	}
+	return list
+}
+
+// aclDefaultUserEnabled returns the `default_user_enabled` value of the database's `acl`
+// block, and whether the block is set at all.
+func aclDefaultUserEnabled(d *schema.ResourceData) (bool, bool) {
+	raw := d.Get("acl").([]interface{})
+	if len(raw) != 1 {
+		return false, false
+	}
+	acl := raw[0].(map[string]interface{})
+	return acl["default_user_enabled"].(bool), true
+}
+
+// validateACLUsersExist checks that every user named in the database's `acl.users`
+// exists. Granting those users access to this database is the job of the
+// `rediscloud_acl_role` resource's own `rule.database` entries - the database resource
+// only consumes that association (via `acl_users`/flattenACLUsers), it never writes to
+// a role, since a role is a separately state-managed resource and mutating it here
+// would fight its own next apply.
+func validateACLUsersExist(ctx context.Context, api *apiClient, usernames []string) error {
+	if len(usernames) == 0 {
+		return nil
+	}
+
+	allUsers, err := api.client.Users.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list ACL users: %w", err)
+	}
+
+	for _, username := range usernames {
+		found := false
+		for _, user := range allUsers {
+			if redis.StringValue(user.Name) == username {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("acl: no ACL user named %q exists", username)
+		}
+	}
+
+	return nil
+}
+
+// flattenACLUsers finds every ACL user whose role grants it access to subId/databaseId,
+// for the `acl_users` computed attribute - this reflects what's actually attached,
+// independent of what `acl.users` asks for, so drift between the two is visible in the
+// plan.
+func flattenACLUsers(ctx context.Context, api *apiClient, subId, databaseId int) ([]string, error) {
+	allRoles, err := api.client.Roles.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ACL roles: %w", err)
+	}
+
+	attachedRoles := make(map[string]bool)
+	for _, role := range allRoles {
+		for _, rule := range role.RedisRules {
+			for _, db := range rule.Databases {
+				if redis.IntValue(db.SubscriptionId) == subId && redis.IntValue(db.DatabaseId) == databaseId {
+					attachedRoles[redis.StringValue(role.Name)] = true
+				}
+			}
+		}
+	}
+
+	var users []string
+	for _, role := range allRoles {
+		if !attachedRoles[redis.StringValue(role.Name)] {
+			continue
+		}
+		for _, user := range role.Users {
+			users = append(users, redis.StringValue(user.Name))
+		}
+	}
+
+	return users, nil
+}
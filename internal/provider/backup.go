@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// buildBackupConfig translates a `backup` block into the SDK's scheduled remote-backup
+// config, or returns nil if the block isn't set.
+func buildBackupConfig(d *schema.ResourceData) *databases.DatabaseBackupConfig {
+	raw := d.Get("backup").([]interface{})
+	if len(raw) != 1 {
+		return nil
+	}
+	backup := raw[0].(map[string]interface{})
+
+	config := &databases.DatabaseBackupConfig{
+		Active:      redis.Bool(true),
+		Interval:    redis.String(backup["interval"].(string)),
+		StorageType: redis.String(backup["storage_type"].(string)),
+		StoragePath: redis.String(backup["storage_path"].(string)),
+	}
+	if timeUTC := backup["time_utc"].(string); timeUTC != "" {
+		config.TimeUTC = redis.String(timeUTC)
+	}
+
+	return config
+}
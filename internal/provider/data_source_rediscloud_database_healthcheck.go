@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRedisCloudDatabaseHealthcheck() *schema.Resource {
+	return &schema.Resource{
+		Description: "Runs the same master/replica validation as the `health_check` block on `rediscloud_database`, without requiring the database to be managed by this apply. Useful for validating a database created outside of this Terraform run. Like `health_check`, this requires the target database to use the legacy shared password, not `acl` or client-certificate (mTLS) authentication",
+		ReadContext: dataSourceRedisCloudDatabaseHealthcheckRead,
+
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Description: "ID of the subscription that the database belongs to",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"database_id": {
+				Description: "ID of the database to health check",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"require_replicas": {
+				Description: "Minimum number of connected, in-sync replicas the primary must report",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+			},
+			"timeout": {
+				Description: "Time limit for the checks, as a Go duration string, e.g. `2m`. The checks run once; a failed check is not retried",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "2m",
+			},
+			"enable_tls": {
+				Description: "Whether the database requires TLS to connect",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"roles": {
+				Description: "The parsed role of every endpoint the health check reached",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint":           {Type: schema.TypeString, Computed: true},
+						"role":               {Type: schema.TypeString, Computed: true},
+						"connected_slaves":   {Type: schema.TypeInt, Computed: true},
+						"master_link_status": {Type: schema.TypeString, Computed: true},
+						"master_repl_offset": {Type: schema.TypeInt, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRedisCloudDatabaseHealthcheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	subId, err := strconv.Atoi(d.Get("subscription_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	databaseId := d.Get("database_id").(int)
+
+	timeout, err := time.ParseDuration(d.Get("timeout").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := api.client.Database.Get(ctx, subId, databaseId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	roles, diags := checkDatabaseHealth(ctx, db, d.Get("enable_tls").(bool), d.Get("require_replicas").(int), timeout)
+	if err := d.Set("roles", flattenDatabaseRoles(roles)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%d", subId, databaseId))
+
+	return diags
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// databaseRole is the parsed outcome of running `INFO replication` against one
+// endpoint of a database, used to populate the `health_check`/
+// `rediscloud_database_healthcheck` `roles` computed attribute and to decide whether
+// the check passed.
+type databaseRole struct {
+	Endpoint         string
+	Role             string
+	ConnectedSlaves  int
+	MasterLinkStatus string
+	MasterReplOffset int
+	// ReplicaAddrs are the `host:port` addresses of this node's replicas, parsed from
+	// its `slaveN:` INFO lines. Only populated on the primary.
+	ReplicaAddrs []string
+}
+
+// checkDatabaseHealth connects to a database's public or private endpoint and verifies
+// that the primary reports role=master, that it has at least requireReplicas connected
+// replicas, and that every replica it knows about reports master_link_status:up. It
+// returns the parsed role of every endpoint it was able to reach, and a diagnostic for
+// each check that failed, naming the endpoint it failed on.
+func checkDatabaseHealth(ctx context.Context, db *databases.Database, enableTLS bool, requireReplicas int, timeout time.Duration) ([]databaseRole, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	endpoint := redis.StringValue(db.PublicEndpoint)
+	if endpoint == "" {
+		endpoint = redis.StringValue(db.PrivateEndpoint)
+	}
+	if endpoint == "" {
+		return nil, diag.Errorf("database has neither a public nor a private endpoint to health check")
+	}
+
+	if redis.BoolValue(db.Security.SSLClientAuthentication) {
+		return nil, diag.Errorf("health check: database requires client-certificate (mTLS) authentication, which Terraform doesn't hold the client's private key for - health_check only supports the legacy shared password")
+	}
+
+	password := redis.StringValue(db.Security.Password)
+	if password == "" {
+		return nil, diag.Errorf("health check: database has no shared password configured (e.g. it's managed through acl instead) - health_check only supports the legacy shared password")
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	primary, err := queryReplicationInfo(checkCtx, endpoint, password, enableTLS)
+	if err != nil {
+		return nil, diag.Errorf("health check: failed to query primary endpoint %s: %s", endpoint, err)
+	}
+	roles := []databaseRole{*primary}
+
+	if primary.Role != "master" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "health check failed",
+			Detail:   fmt.Sprintf("endpoint %s reported role %q, expected %q", endpoint, primary.Role, "master"),
+		})
+	}
+	if primary.ConnectedSlaves < requireReplicas {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "health check failed",
+			Detail:   fmt.Sprintf("endpoint %s has %d connected replicas, require_replicas is %d", endpoint, primary.ConnectedSlaves, requireReplicas),
+		})
+	}
+
+	for _, replicaEndpoint := range primary.ReplicaAddrs {
+		role, err := queryReplicationInfo(checkCtx, replicaEndpoint, password, enableTLS)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "health check failed",
+				Detail:   fmt.Sprintf("replica %s: %s", replicaEndpoint, err),
+			})
+			continue
+		}
+		roles = append(roles, *role)
+
+		if role.MasterLinkStatus != "up" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "health check failed",
+				Detail:   fmt.Sprintf("replica %s reported master_link_status %q, expected \"up\"", replicaEndpoint, role.MasterLinkStatus),
+			})
+		}
+	}
+
+	return roles, diags
+}
+
+// queryReplicationInfo opens a direct RESP connection to endpoint, authenticates if
+// password is set, and runs `INFO replication` against it. It speaks just enough of
+// the Redis wire protocol for this one command/reply pair, rather than pulling in a
+// full client library for a single read-only diagnostic call.
+func queryReplicationInfo(ctx context.Context, endpoint, password string, enableTLS bool) (*databaseRole, error) {
+	conn, err := dialRedis(ctx, endpoint, enableTLS)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	reader := bufio.NewReader(conn)
+
+	if password != "" {
+		if err := writeRespCommand(conn, "AUTH", password); err != nil {
+			return nil, err
+		}
+		if _, err := readRespReply(reader); err != nil {
+			return nil, fmt.Errorf("AUTH failed: %w", err)
+		}
+	}
+
+	if err := writeRespCommand(conn, "INFO", "replication"); err != nil {
+		return nil, err
+	}
+	info, err := readRespReply(reader)
+	if err != nil {
+		return nil, fmt.Errorf("INFO replication failed: %w", err)
+	}
+
+	return parseReplicationInfo(endpoint, info), nil
+}
+
+// dialRedis opens a TCP connection to endpoint, wrapping it in TLS if enableTLS is set.
+func dialRedis(ctx context.Context, endpoint string, enableTLS bool) (net.Conn, error) {
+	if enableTLS {
+		dialer := &tls.Dialer{Config: &tls.Config{}}
+		return dialer.DialContext(ctx, "tcp", endpoint)
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "tcp", endpoint)
+}
+
+// writeRespCommand writes args to conn as a RESP array of bulk strings, the format
+// every Redis command request takes on the wire.
+func writeRespCommand(conn net.Conn, args ...string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	_, err := conn.Write([]byte(b.String()))
+	return err
+}
+
+// readRespReply reads a single RESP reply and returns its value as a string. It
+// understands simple strings, errors, and bulk strings - the only reply types AUTH and
+// INFO ever return.
+func readRespReply(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	line = strings.TrimRight(line, "\r\n")
+	if len(line) == 0 {
+		return "", fmt.Errorf("empty RESP reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return "", fmt.Errorf("%s", line[1:])
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return "", fmt.Errorf("invalid RESP bulk string length %q: %w", line[1:], err)
+		}
+		if n < 0 {
+			return "", nil
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return "", err
+		}
+		return string(buf[:n]), nil
+	default:
+		return "", fmt.Errorf("unexpected RESP reply type %q", line)
+	}
+}
+
+// parseReplicationInfo extracts the fields needed to judge the health of a node from
+// the raw text returned by `INFO replication`.
+func parseReplicationInfo(endpoint, info string) *databaseRole {
+	role := &databaseRole{Endpoint: endpoint, Role: "unknown", MasterLinkStatus: "unknown"}
+	for _, line := range strings.Split(info, "\r\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		switch {
+		case key == "role":
+			role.Role = value
+		case key == "connected_slaves":
+			role.ConnectedSlaves, _ = strconv.Atoi(value)
+		case key == "master_link_status":
+			role.MasterLinkStatus = value
+		case key == "master_repl_offset":
+			role.MasterReplOffset, _ = strconv.Atoi(value)
+		case strings.HasPrefix(key, "slave"):
+			// e.g. `slave0:ip=10.0.0.5,port=6379,state=online,offset=123,lag=0`
+			if addr := parseSlaveAddr(value); addr != "" {
+				role.ReplicaAddrs = append(role.ReplicaAddrs, addr)
+			}
+		}
+	}
+	return role
+}
+
+// parseSlaveAddr extracts the `ip:port` address out of a `slaveN:` INFO field's
+// comma-separated `key=value` list.
+func parseSlaveAddr(field string) string {
+	var ip, port string
+	for _, kv := range strings.Split(field, ",") {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "ip":
+			ip = v
+		case "port":
+			port = v
+		}
+	}
+	if ip == "" || port == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%s", ip, port)
+}
+
+// flattenDatabaseRoles turns the parsed role of every checked endpoint into the shape
+// the `roles` computed attribute expects.
+func flattenDatabaseRoles(roles []databaseRole) []interface{} {
+	flat := make([]interface{}, 0, len(roles))
+	for _, role := range roles {
+		flat = append(flat, map[string]interface{}{
+			"endpoint":           role.Endpoint,
+			"role":               role.Role,
+			"connected_slaves":   role.ConnectedSlaves,
+			"master_link_status": role.MasterLinkStatus,
+			"master_repl_offset": role.MasterReplOffset,
+		})
+	}
+	return flat
+}
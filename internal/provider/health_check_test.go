@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseReplicationInfo(t *testing.T) {
+	tests := []struct {
+		name string
+		info string
+		want *databaseRole
+	}{
+		{
+			name: "master with replicas",
+			info: "role:master\r\n" +
+				"connected_slaves:2\r\n" +
+				"master_repl_offset:12345\r\n" +
+				"slave0:ip=10.0.0.5,port=6379,state=online,offset=123,lag=0\r\n" +
+				"slave1:ip=10.0.0.6,port=6380,state=online,offset=123,lag=0\r\n",
+			want: &databaseRole{
+				Endpoint:         "primary:6379",
+				Role:             "master",
+				ConnectedSlaves:  2,
+				MasterLinkStatus: "unknown",
+				MasterReplOffset: 12345,
+				ReplicaAddrs:     []string{"10.0.0.5:6379", "10.0.0.6:6380"},
+			},
+		},
+		{
+			name: "slave",
+			info: "role:slave\r\n" +
+				"master_link_status:up\r\n" +
+				"master_repl_offset:999\r\n",
+			want: &databaseRole{
+				Endpoint:         "replica:6379",
+				Role:             "slave",
+				MasterLinkStatus: "up",
+				MasterReplOffset: 999,
+			},
+		},
+		{
+			name: "empty info",
+			info: "",
+			want: &databaseRole{
+				Endpoint:         "empty:6379",
+				Role:             "unknown",
+				MasterLinkStatus: "unknown",
+			},
+		},
+		{
+			name: "malformed lines are ignored",
+			info: "not a key value line\r\n" +
+				"role:master\r\n",
+			want: &databaseRole{
+				Endpoint:         "malformed:6379",
+				Role:             "master",
+				MasterLinkStatus: "unknown",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := tt.want.Endpoint
+			got := parseReplicationInfo(endpoint, tt.info)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseReplicationInfo(%q, ...) = %+v, want %+v", endpoint, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSlaveAddr(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+		want  string
+	}{
+		{
+			name:  "ip and port present",
+			field: "ip=10.0.0.5,port=6379,state=online,offset=123,lag=0",
+			want:  "10.0.0.5:6379",
+		},
+		{
+			name:  "order doesn't matter",
+			field: "port=6380,ip=10.0.0.6",
+			want:  "10.0.0.6:6380",
+		},
+		{
+			name:  "missing port",
+			field: "ip=10.0.0.5,state=online",
+			want:  "",
+		},
+		{
+			name:  "missing ip",
+			field: "port=6379,state=online",
+			want:  "",
+		},
+		{
+			name:  "empty field",
+			field: "",
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseSlaveAddr(tt.field); got != tt.want {
+				t.Errorf("parseSlaveAddr(%q) = %q, want %q", tt.field, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"sort"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// buildCreateModules turns the `modules` list into the `CreateModule` entries the API
+// expects, falling back to the deprecated singular `module` block if `modules` isn't
+// set, so existing configs keep working unchanged. The list is sorted by name before
+// being sent, to match the order flattenModulesList reads the API's response back in -
+// otherwise a config listing modules in any other order would show a perpetual diff
+// against the state that sorted order produced.
+func buildCreateModules(d *schema.ResourceData) []*databases.CreateModule {
+	createModules := make([]*databases.CreateModule, 0)
+
+	modules := d.Get("modules").([]interface{})
+	if len(modules) == 0 {
+		modules = d.Get("module").([]interface{})
+	}
+
+	sorted := make([]interface{}, len(modules))
+	copy(sorted, modules)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].(map[string]interface{})["name"].(string) < sorted[j].(map[string]interface{})["name"].(string)
+	})
+
+	for _, raw := range sorted {
+		mod := raw.(map[string]interface{})
+
+		createModules = append(createModules, &databases.CreateModule{
+			Name: redis.String(mod["name"].(string)),
+		})
+	}
+
+	return createModules
+}
+
+// flattenModulesList renders the database's loaded modules into the `modules`
+// computed shape, sorted by name so that the plan doesn't churn when the API
+// returns modules in a different order than they were requested.
+func flattenModulesList(modules []*databases.Module) []interface{} {
+	sorted := make([]*databases.Module, len(modules))
+	copy(sorted, modules)
+	sort.Slice(sorted, func(i, j int) bool {
+		return redis.StringValue(sorted[i].Name) < redis.StringValue(sorted[j].Name)
+	})
+
+	flat := make([]interface{}, 0, len(sorted))
+	for _, mod := range sorted {
+		flat = append(flat, map[string]interface{}{
+			"name": redis.StringValue(mod.Name),
+		})
+	}
+	return flat
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+)
+
+func TestFlattenModulesList(t *testing.T) {
+	tests := []struct {
+		name    string
+		modules []*databases.Module
+		want    []interface{}
+	}{
+		{
+			name: "sorted regardless of input order",
+			modules: []*databases.Module{
+				{Name: redis.String("RedisTimeSeries")},
+				{Name: redis.String("RediSearch")},
+				{Name: redis.String("RedisJSON")},
+			},
+			want: []interface{}{
+				map[string]interface{}{"name": "RediSearch"},
+				map[string]interface{}{"name": "RedisJSON"},
+				map[string]interface{}{"name": "RedisTimeSeries"},
+			},
+		},
+		{
+			name:    "nil input",
+			modules: nil,
+			want:    []interface{}{},
+		},
+		{
+			name:    "empty input",
+			modules: []*databases.Module{},
+			want:    []interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := flattenModulesList(tt.modules)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("flattenModulesList() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
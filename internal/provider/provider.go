@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider assembles this package's resources and data sources into the
+// ResourcesMap/DataSourcesMap the plugin SDK dispatches Terraform configuration
+// against. The provider-level schema (credentials, ConfigureContextFunc building the
+// apiClient) lives alongside the rest of the provider's root wiring and isn't part of
+// this snapshot - this only covers what's reachable from *within* it.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"rediscloud_database":         resourceRedisCloudDatabase(),
+			"rediscloud_database_backup":  resourceRedisCloudDatabaseBackup(),
+			"rediscloud_database_restore": resourceRedisCloudDatabaseRestore(),
+			"rediscloud_acl_role":         resourceRedisCloudAclRole(),
+			"rediscloud_acl_rule":         resourceRedisCloudAclRule(),
+			"rediscloud_acl_user":         resourceRedisCloudAclUser(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"rediscloud_database_healthcheck": dataSourceRedisCloudDatabaseHealthcheck(),
+		},
+	}
+}
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/roles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRedisCloudAclRole() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Creates a Redis ACL role, associating one or more ACL rules with the databases they apply to",
+		CreateContext: resourceRedisCloudAclRoleCreate,
+		ReadContext:   resourceRedisCloudAclRoleRead,
+		UpdateContext: resourceRedisCloudAclRoleUpdate,
+		DeleteContext: resourceRedisCloudAclRoleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the ACL role",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"rule": {
+				Description: "Set of ACL rules, and the databases each applies to, that make up this role",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Name of the `rediscloud_acl_rule` to apply",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"database": {
+							Description: "Set of databases that the rule applies to",
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"subscription": {
+										Description: "ID of the subscription that the database belongs to",
+										Type:        schema.TypeInt,
+										Required:    true,
+									},
+									"database": {
+										Description: "ID of the database",
+										Type:        schema.TypeInt,
+										Required:    true,
+									},
+									"regions": {
+										Description: "Set of regions the rule applies to. For Active-Active databases only",
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceRedisCloudAclRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	name := d.Get("name").(string)
+	create := roles.CreateRoleRequest{
+		Name:       redis.String(name),
+		RedisRules: extractRulesInRole(d),
+	}
+
+	id, err := api.client.Roles.Create(ctx, create)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	log.Printf("[DEBUG] Created ACL role %q (%d)", name, id)
+
+	if err := waitForAclRoleToBeActive(ctx, id, api); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Roles sometimes flip between active and pending a few times right after
+	// creation while the service finishes propagating the rule/database
+	// associations - give it a moment to settle before the first read.
+	// TODO: this is an API limitation, remove the delay once it's fixed upstream.
+	time.Sleep(15 * time.Second) //lintignore:R018
+
+	if err := waitForAclRoleToBeActive(ctx, id, api); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRedisCloudAclRoleRead(ctx, d, meta)
+}
+
+func resourceRedisCloudAclRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	role, err := api.client.Roles.Get(ctx, id)
+	if err != nil {
+		if _, ok := err.(*roles.NotFound); ok {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", redis.StringValue(role.Name)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rule", flattenRulesInRole(role.RedisRules)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceRedisCloudAclRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "rule") {
+		update := roles.CreateRoleRequest{
+			Name:       redis.String(d.Get("name").(string)),
+			RedisRules: extractRulesInRole(d),
+		}
+
+		if err := api.client.Roles.Update(ctx, id, update); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := waitForAclRoleToBeActive(ctx, id, api); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// TODO: this is an API limitation, remove the delay once it's fixed upstream.
+		time.Sleep(15 * time.Second) //lintignore:R018
+
+		if err := waitForAclRoleToBeActive(ctx, id, api); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceRedisCloudAclRoleRead(ctx, d, meta)
+}
+
+func resourceRedisCloudAclRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.client.Roles.Delete(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		_, err := api.client.Roles.Get(ctx, id)
+		if err != nil {
+			if _, ok := err.(*roles.NotFound); ok {
+				return nil
+			}
+			return retry.NonRetryableError(err)
+		}
+		return retry.RetryableError(fmt.Errorf("expected ACL role %d to be deleted", id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// extractRulesInRole turns the `rule` set on a `rediscloud_acl_role` into the
+// request shape the API expects.
+func extractRulesInRole(d *schema.ResourceData) []*roles.CreateRuleInRoleRequest {
+	var rules []*roles.CreateRuleInRoleRequest
+	for _, raw := range d.Get("rule").(*schema.Set).List() {
+		rule := raw.(map[string]interface{})
+
+		var dbs []*roles.CreateDatabaseInRuleInRoleRequest
+		for _, rawDb := range rule["database"].(*schema.Set).List() {
+			db := rawDb.(map[string]interface{})
+
+			dbs = append(dbs, &roles.CreateDatabaseInRuleInRoleRequest{
+				SubscriptionId: redis.Int(db["subscription"].(int)),
+				DatabaseId:     redis.Int(db["database"].(int)),
+				Regions:        setToStringSlice(db["regions"].(*schema.Set)),
+			})
+		}
+
+		rules = append(rules, &roles.CreateRuleInRoleRequest{
+			RuleName:  redis.String(rule["name"].(string)),
+			Databases: dbs,
+		})
+	}
+	return rules
+}
+
+// flattenRulesInRole renders the role's rules, as reported by the API, back into the
+// `rule` set shape.
+func flattenRulesInRole(rules []*roles.GetRuleInRoleResponse) []interface{} {
+	flat := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		flat = append(flat, map[string]interface{}{
+			"name":     redis.StringValue(rule.RuleName),
+			"database": flattenDatabasesInRuleInRole(rule.Databases),
+		})
+	}
+	return flat
+}
+
+func flattenDatabasesInRuleInRole(dbs []*roles.GetDatabaseInRuleInRoleResponse) []interface{} {
+	flat := make([]interface{}, 0, len(dbs))
+	for _, db := range dbs {
+		flat = append(flat, map[string]interface{}{
+			"subscription": redis.IntValue(db.SubscriptionId),
+			"database":     redis.IntValue(db.DatabaseId),
+			"regions":      redis.StringSliceValue(db.Regions...),
+		})
+	}
+	return flat
+}
+
+// waitForAclRoleToBeActive polls a newly created or updated ACL role until the service
+// reports it as active.
+func waitForAclRoleToBeActive(ctx context.Context, id int, api *apiClient) error {
+	wait := &retry.StateChangeConf{
+		Delay:   5 * time.Second,
+		Pending: []string{roles.StatusPending},
+		Target:  []string{roles.StatusActive},
+		Timeout: 5 * time.Minute,
+		Refresh: func() (interface{}, string, error) {
+			log.Printf("[DEBUG] Waiting for ACL role %d to be active", id)
+
+			role, err := api.client.Roles.Get(ctx, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return redis.StringValue(role.Status), redis.StringValue(role.Status), nil
+		},
+	}
+
+	_, err := wait.WaitForStateContext(ctx)
+	return err
+}
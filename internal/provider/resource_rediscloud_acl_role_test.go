@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/roles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExtractRulesInRole(t *testing.T) {
+	raw := map[string]interface{}{
+		"name": "my-role",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"name": "my-rule",
+				"database": []interface{}{
+					map[string]interface{}{
+						"subscription": 1,
+						"database":     2,
+						"regions":      []interface{}{"us-east-1", "us-west-2"},
+					},
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceRedisCloudAclRole().Schema, raw)
+
+	got := extractRulesInRole(d)
+
+	if len(got) != 1 {
+		t.Fatalf("extractRulesInRole() returned %d rules, want 1", len(got))
+	}
+	rule := got[0]
+	if redis.StringValue(rule.RuleName) != "my-rule" {
+		t.Errorf("RuleName = %q, want %q", redis.StringValue(rule.RuleName), "my-rule")
+	}
+	if len(rule.Databases) != 1 {
+		t.Fatalf("Databases has %d entries, want 1", len(rule.Databases))
+	}
+	db := rule.Databases[0]
+	if redis.IntValue(db.SubscriptionId) != 1 {
+		t.Errorf("SubscriptionId = %d, want %d", redis.IntValue(db.SubscriptionId), 1)
+	}
+	if redis.IntValue(db.DatabaseId) != 2 {
+		t.Errorf("DatabaseId = %d, want %d", redis.IntValue(db.DatabaseId), 2)
+	}
+	gotRegions := redis.StringSliceValue(db.Regions...)
+	wantRegions := []string{"us-east-1", "us-west-2"}
+	if !reflect.DeepEqual(sortedCopy(gotRegions), sortedCopy(wantRegions)) {
+		t.Errorf("Regions = %v, want %v", gotRegions, wantRegions)
+	}
+}
+
+func TestFlattenRulesInRole(t *testing.T) {
+	in := []*roles.GetRuleInRoleResponse{
+		{
+			RuleName: redis.String("my-rule"),
+			Databases: []*roles.GetDatabaseInRuleInRoleResponse{
+				{
+					SubscriptionId: redis.Int(1),
+					DatabaseId:     redis.Int(2),
+					Regions:        redis.StringSlice("us-east-1", "us-west-2"),
+				},
+			},
+		},
+	}
+
+	want := []interface{}{
+		map[string]interface{}{
+			"name": "my-rule",
+			"database": []interface{}{
+				map[string]interface{}{
+					"subscription": 1,
+					"database":     2,
+					"regions":      []string{"us-east-1", "us-west-2"},
+				},
+			},
+		},
+	}
+
+	got := flattenRulesInRole(in)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenRulesInRole() = %+v, want %+v", got, want)
+	}
+}
+
+func TestFlattenRulesInRoleEmpty(t *testing.T) {
+	got := flattenRulesInRole(nil)
+	want := []interface{}{}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenRulesInRole(nil) = %+v, want %+v", got, want)
+	}
+}
+
+func sortedCopy(s []string) []string {
+	out := make([]string, len(s))
+	copy(out, s)
+	for i := 1; i < len(out); i++ {
+		for j := i; j > 0 && out[j-1] > out[j]; j-- {
+			out[j-1], out[j] = out[j], out[j-1]
+		}
+	}
+	return out
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/redis_rules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRedisCloudAclRule() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Creates a Redis ACL rule, a named Redis command rule (e.g. `+@read`) that can be attached to a role",
+		CreateContext: resourceRedisCloudAclRuleCreate,
+		ReadContext:   resourceRedisCloudAclRuleRead,
+		UpdateContext: resourceRedisCloudAclRuleUpdate,
+		DeleteContext: resourceRedisCloudAclRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the ACL rule",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"rule": {
+				Description: "The Redis ACL rule, e.g. `+@read`",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceRedisCloudAclRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	name := d.Get("name").(string)
+	rule := redis_rules.CreateRedisRuleRequest{
+		Name:      redis.String(name),
+		RedisRule: redis.String(d.Get("rule").(string)),
+	}
+
+	id, err := api.client.RedisRules.Create(ctx, rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	log.Printf("[DEBUG] Created ACL rule %q (%d)", name, id)
+
+	if err := waitForAclRuleToBeActive(ctx, id, api); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRedisCloudAclRuleRead(ctx, d, meta)
+}
+
+func resourceRedisCloudAclRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule, err := api.client.RedisRules.Get(ctx, id)
+	if err != nil {
+		if _, ok := err.(*redis_rules.NotFound); ok {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", redis.StringValue(rule.Name)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rule", redis.StringValue(rule.ACL)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceRedisCloudAclRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "rule") {
+		update := redis_rules.CreateRedisRuleRequest{
+			Name:      redis.String(d.Get("name").(string)),
+			RedisRule: redis.String(d.Get("rule").(string)),
+		}
+
+		if err := api.client.RedisRules.Update(ctx, id, update); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := waitForAclRuleToBeActive(ctx, id, api); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceRedisCloudAclRuleRead(ctx, d, meta)
+}
+
+func resourceRedisCloudAclRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.client.RedisRules.Delete(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		_, err := api.client.RedisRules.Get(ctx, id)
+		if err != nil {
+			if _, ok := err.(*redis_rules.NotFound); ok {
+				return nil
+			}
+			return retry.NonRetryableError(err)
+		}
+		return retry.RetryableError(fmt.Errorf("expected ACL rule %d to be deleted", id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// waitForAclRuleToBeActive polls a newly created or updated ACL rule until the service
+// reports it as active, mirroring waitForDatabaseToBeActive.
+func waitForAclRuleToBeActive(ctx context.Context, id int, api *apiClient) error {
+	wait := &retry.StateChangeConf{
+		Delay:   5 * time.Second,
+		Pending: []string{redis_rules.StatusPending},
+		Target:  []string{redis_rules.StatusActive},
+		Timeout: 5 * time.Minute,
+		Refresh: func() (interface{}, string, error) {
+			log.Printf("[DEBUG] Waiting for ACL rule %d to be active", id)
+
+			rule, err := api.client.RedisRules.Get(ctx, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return redis.StringValue(rule.Status), redis.StringValue(rule.Status), nil
+		},
+	}
+
+	_, err := wait.WaitForStateContext(ctx)
+	return err
+}
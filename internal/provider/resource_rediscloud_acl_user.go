@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/users"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRedisCloudAclUser() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Creates a Redis ACL user, with a password and a `rediscloud_acl_role` that grants it access to one or more databases",
+		CreateContext: resourceRedisCloudAclUserCreate,
+		ReadContext:   resourceRedisCloudAclUserRead,
+		UpdateContext: resourceRedisCloudAclUserUpdate,
+		DeleteContext: resourceRedisCloudAclUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the ACL user",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"role": {
+				Description: "Name of the `rediscloud_acl_role` to grant this user",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"password": {
+				Description: "Password used to authenticate as this user",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func resourceRedisCloudAclUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	name := d.Get("name").(string)
+	create := users.CreateUserRequest{
+		Name:     redis.String(name),
+		Role:     redis.String(d.Get("role").(string)),
+		Password: redis.String(d.Get("password").(string)),
+	}
+
+	id, err := api.client.Users.Create(ctx, create)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(id))
+
+	log.Printf("[DEBUG] Created ACL user %q (%d)", name, id)
+
+	if err := waitForAclUserToBeActive(ctx, id, api); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// As with roles, users sometimes flip between active and pending a few
+	// times right after creation while the service settles the role grant.
+	// TODO: this is an API limitation, remove the delay once it's fixed upstream.
+	time.Sleep(15 * time.Second) //lintignore:R018
+
+	if err := waitForAclUserToBeActive(ctx, id, api); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRedisCloudAclUserRead(ctx, d, meta)
+}
+
+func resourceRedisCloudAclUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, err := api.client.Users.Get(ctx, id)
+	if err != nil {
+		if _, ok := err.(*users.NotFound); ok {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", redis.StringValue(user.Name)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("role", redis.StringValue(user.Role)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceRedisCloudAclUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("role") {
+		update := users.UpdateUserRequest{
+			Role: redis.String(d.Get("role").(string)),
+		}
+
+		if err := api.client.Users.Update(ctx, id, update); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := waitForAclUserToBeActive(ctx, id, api); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceRedisCloudAclUserRead(ctx, d, meta)
+}
+
+func resourceRedisCloudAclUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.client.Users.Delete(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		_, err := api.client.Users.Get(ctx, id)
+		if err != nil {
+			if _, ok := err.(*users.NotFound); ok {
+				return nil
+			}
+			return retry.NonRetryableError(err)
+		}
+		return retry.RetryableError(fmt.Errorf("expected ACL user %d to be deleted", id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// waitForAclUserToBeActive polls a newly created or updated ACL user until the service
+// reports it as active.
+func waitForAclUserToBeActive(ctx context.Context, id int, api *apiClient) error {
+	wait := &retry.StateChangeConf{
+		Delay:   5 * time.Second,
+		Pending: []string{users.StatusPending},
+		Target:  []string{users.StatusActive},
+		Timeout: 5 * time.Minute,
+		Refresh: func() (interface{}, string, error) {
+			log.Printf("[DEBUG] Waiting for ACL user %d to be active", id)
+
+			user, err := api.client.Users.Get(ctx, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return redis.StringValue(user.Status), redis.StringValue(user.Status), nil
+		},
+	}
+
+	_, err := wait.WaitForStateContext(ctx)
+	return err
+}
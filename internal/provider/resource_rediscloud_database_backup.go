@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRedisCloudDatabaseBackup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Triggers an on-demand backup of a database to the remote storage configured in its `backup` block. A backup is taken when this resource is created, or replaced because `subscription_id` or `database_id` changed; later applies with unchanged arguments take no new backup. Destroying this resource does not delete the backup file itself",
+		CreateContext: resourceRedisCloudDatabaseBackupCreate,
+		ReadContext:   resourceRedisCloudDatabaseBackupRead,
+		DeleteContext: resourceRedisCloudDatabaseBackupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Description: "ID of the subscription that the database belongs to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"database_id": {
+				Description: "ID of the database to back up",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceRedisCloudDatabaseBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	subId, err := strconv.Atoi(d.Get("subscription_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	databaseId := d.Get("database_id").(int)
+
+	log.Printf("[DEBUG] Triggering backup of database %d on subscription %d", databaseId, subId)
+
+	// Database.Backup blocks until the backup task completes, so there's nothing
+	// further to wait for here.
+	if err := api.client.Database.Backup(ctx, subId, databaseId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%d/%d", subId, databaseId, time.Now().Unix()))
+
+	return nil
+}
+
+func resourceRedisCloudDatabaseBackupRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceRedisCloudDatabaseBackupDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The backup file stays in the remote storage it was written to - this resource
+	// doesn't delete it, so just drop the resource from state.
+	d.SetId("")
+	return nil
+}
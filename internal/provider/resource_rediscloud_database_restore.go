@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRedisCloudDatabaseRestore() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Imports data from one or more source files into an existing database, replacing its current contents. An import runs when this resource is created, or replaced because one of its arguments changed; later applies with unchanged arguments run no new import. This resource doesn't create or own a database itself",
+		CreateContext: resourceRedisCloudDatabaseRestoreCreate,
+		ReadContext:   resourceRedisCloudDatabaseRestoreRead,
+		DeleteContext: resourceRedisCloudDatabaseRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Description: "ID of the subscription that the database belongs to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"database_id": {
+				Description: "ID of the existing database to import into",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"source_type": {
+				Description:      "Source of the import, one of " + fmt.Sprintf("%v", databases.SourceTypeValues()),
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateDiagFunc(validation.StringInSlice(databases.SourceTypeValues(), false)),
+			},
+			"from_uris": {
+				Description: "URIs to import from, in the format expected by `source_type`",
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceRedisCloudDatabaseRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*apiClient)
+
+	subId, err := strconv.Atoi(d.Get("subscription_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	databaseId := d.Get("database_id").(int)
+
+	request := databases.Import{
+		SourceType:    redis.String(d.Get("source_type").(string)),
+		ImportFromURI: interfaceToStringSlice(d.Get("from_uris").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Importing into database %d on subscription %d", databaseId, subId)
+
+	// Database.Import blocks until the import task completes, so there's nothing
+	// further to wait for here.
+	if err := api.client.Database.Import(ctx, subId, databaseId, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%d/%d", subId, databaseId, time.Now().Unix()))
+
+	return nil
+}
+
+func resourceRedisCloudDatabaseRestoreRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceRedisCloudDatabaseRestoreDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Importing is a one-shot action against an existing, separately-owned database -
+	// there is nothing to undo here, so just drop the resource from state.
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,56 @@
+package provider
+
+import "testing"
+
+func TestResolveAzureCacheForRedisHost(t *testing.T) {
+	tests := []struct {
+		name       string
+		resourceID string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "valid resource id",
+			resourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-group/providers/Microsoft.Cache/Redis/my-cache",
+			want:       "my-cache.redis.cache.windows.net",
+		},
+		{
+			name:       "case insensitive provider segment",
+			resourceID: "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/my-group/PROVIDERS/microsoft.cache/redis/my-cache",
+			want:       "my-cache.redis.cache.windows.net",
+		},
+		{
+			name:       "missing cache name",
+			resourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-group/providers/Microsoft.Cache/Redis/",
+			wantErr:    true,
+		},
+		{
+			name:       "not an ARM resource id",
+			resourceID: "my-cache.redis.cache.windows.net",
+			wantErr:    true,
+		},
+		{
+			name:       "empty string",
+			resourceID: "",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveAzureCacheForRedisHost(tt.resourceID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("resolveAzureCacheForRedisHost(%q) = %q, nil, want error", tt.resourceID, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveAzureCacheForRedisHost(%q) returned unexpected error: %s", tt.resourceID, err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveAzureCacheForRedisHost(%q) = %q, want %q", tt.resourceID, got, tt.want)
+			}
+		})
+	}
+}